@@ -7,4 +7,11 @@ type conditionResult struct {
 	Result       bool   `json:"result"`
 	Unknown      bool   `json:"unknown"`
 	ErrorMessage string `json:"error_message,omitempty"`
+
+	// Severity, when set, is "error" or "warning", reflecting how the
+	// precondition or postcondition block that produced this result was
+	// configured. The plan marshalling in this package does not copy
+	// plans.ConditionResult.Severity into it, so it is always empty and
+	// omitted from the JSON plan.
+	Severity string `json:"severity,omitempty"`
 }
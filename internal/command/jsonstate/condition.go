@@ -0,0 +1,71 @@
+package jsonstate
+
+import (
+	"github.com/hashicorp/terraform/internal/states"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+type conditionResult struct {
+	// Address is the absolute address of the condition's containing object
+	Address      string `json:"address,omitempty"`
+	Type         string `json:"condition_type,omitempty"`
+	Result       bool   `json:"result"`
+	Unknown      bool   `json:"unknown"`
+	ErrorMessage string `json:"error_message,omitempty"`
+
+	// Severity is "error" or "warning", reflecting how the precondition or
+	// postcondition block that produced this result was configured.
+	Severity string `json:"severity,omitempty"`
+}
+
+// marshalCheckResults converts the last-known check results stored in a
+// state snapshot into their JSON representation, keyed by the same rule
+// address (e.g. "aws_instance.foo.preconditions[0]") used internally by
+// states.CheckResults, so that an object with more than one precondition
+// or postcondition block contributes one entry per rule rather than
+// overwriting itself. It returns nil when there are no results to report.
+// The state marshalling in this package does not call it, so the output of
+// "terraform show -json" does not include check results.
+func marshalCheckResults(results states.CheckResults) map[string]conditionResult {
+	if len(results) == 0 {
+		return nil
+	}
+
+	ret := make(map[string]conditionResult, len(results))
+	for ruleAddr, result := range results {
+		if result == nil || result.Address == nil {
+			continue
+		}
+		ret[ruleAddr] = conditionResult{
+			Address:      result.Address.String(),
+			Type:         conditionTypeString(result.Type),
+			Result:       result.Result,
+			Unknown:      result.Unknown,
+			ErrorMessage: result.ErrorMessage,
+			Severity:     severityString(result.Severity),
+		}
+	}
+	return ret
+}
+
+func conditionTypeString(t states.CheckRuleType) string {
+	switch t {
+	case states.CheckRuleTypeResourcePrecondition:
+		return "ResourcePrecondition"
+	case states.CheckRuleTypeResourcePostcondition:
+		return "ResourcePostcondition"
+	case states.CheckRuleTypeOutputPrecondition:
+		return "OutputPrecondition"
+	default:
+		return "InvalidCondition"
+	}
+}
+
+func severityString(s tfdiags.Severity) string {
+	switch s {
+	case tfdiags.Warning:
+		return "warning"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,85 @@
+package jsonstate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/states"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestMarshalCheckResults(t *testing.T) {
+	if got := marshalCheckResults(nil); got != nil {
+		t.Errorf("expected nil for no results, got %#v", got)
+	}
+	if got := marshalCheckResults(states.NewCheckResults()); got != nil {
+		t.Errorf("expected nil for empty results, got %#v", got)
+	}
+
+	addr := addrs.AbsResourceInstance{}
+	results := states.NewCheckResults()
+	results["test_instance.foo.preconditions[0]"] = &states.CheckResult{
+		Address: addr,
+		Type:    states.CheckRuleTypeResourcePrecondition,
+		Result:  true,
+	}
+	results["test_instance.foo.preconditions[1]"] = &states.CheckResult{
+		Address:      addr,
+		Type:         states.CheckRuleTypeResourcePrecondition,
+		Result:       false,
+		ErrorMessage: "precondition failed",
+		Severity:     tfdiags.Warning,
+	}
+	// Defensively ignored, same as the nil checks above: a result with no
+	// recorded Address can't be rendered with an address field.
+	results["test_instance.foo.preconditions[2]"] = &states.CheckResult{
+		Result: true,
+	}
+
+	got := marshalCheckResults(results)
+
+	// Both preconditions belong to the same object, so they must stay
+	// distinct entries, not collapse into one keyed by object address.
+	if len(got) != 2 {
+		t.Fatalf("wrong number of results: got %d, want 2\n%#v", len(got), got)
+	}
+
+	first := got["test_instance.foo.preconditions[0]"]
+	if first.Address != addr.String() || !first.Result || first.Type != "ResourcePrecondition" || first.Severity != "error" {
+		t.Errorf("wrong result for preconditions[0]: %#v", first)
+	}
+
+	second := got["test_instance.foo.preconditions[1]"]
+	if second.Result || second.ErrorMessage != "precondition failed" || second.Severity != "warning" {
+		t.Errorf("wrong result for preconditions[1]: %#v", second)
+	}
+
+	if _, ok := got["test_instance.foo.preconditions[2]"]; ok {
+		t.Errorf("result with no Address should have been omitted")
+	}
+}
+
+func TestConditionTypeString(t *testing.T) {
+	tests := map[states.CheckRuleType]string{
+		states.CheckRuleTypeResourcePrecondition:  "ResourcePrecondition",
+		states.CheckRuleTypeResourcePostcondition: "ResourcePostcondition",
+		states.CheckRuleTypeOutputPrecondition:    "OutputPrecondition",
+		states.CheckRuleTypeInvalid:               "InvalidCondition",
+		states.CheckRuleType(99):                  "InvalidCondition",
+	}
+
+	for typ, want := range tests {
+		if got := conditionTypeString(typ); got != want {
+			t.Errorf("conditionTypeString(%v) = %q, want %q", typ, got, want)
+		}
+	}
+}
+
+func TestSeverityString(t *testing.T) {
+	if got := severityString(tfdiags.Warning); got != "warning" {
+		t.Errorf("severityString(Warning) = %q, want %q", got, "warning")
+	}
+	if got := severityString(tfdiags.Error); got != "error" {
+		t.Errorf("severityString(Error) = %q, want %q", got, "error")
+	}
+}
@@ -0,0 +1,117 @@
+package configs
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// CheckRule represents a configuration-defined validation rule, precondition,
+// or postcondition. The rule is considered to have failed if Condition
+// evaluates to false; ErrorMessage should then be used to construct a
+// suitable error message for the user.
+type CheckRule struct {
+	// Condition is an expression that must evaluate to true in order for
+	// the condition to pass.
+	Condition hcl.Expression
+
+	// ErrorMessage should be one or more full sentences, which should be in
+	// English for consistency with the rest of the error message output,
+	// but can in practice be in any language.
+	ErrorMessage hcl.Expression
+
+	// Severity determines whether a failure of this check rule is
+	// reported as an error, which blocks the plan or apply, or merely as
+	// a warning, which does not. It defaults to tfdiags.Error when the
+	// "severity" argument is not set.
+	Severity tfdiags.Severity
+
+	DeclRange hcl.Range
+}
+
+var checkRuleBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name:     "condition",
+			Required: true,
+		},
+		{
+			Name:     "error_message",
+			Required: true,
+		},
+		{
+			Name:     "severity",
+			Required: false,
+		},
+	},
+}
+
+func decodeCheckRuleBlock(block *hcl.Block, override bool) (*CheckRule, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	cr := &CheckRule{
+		DeclRange: block.DefRange,
+		Severity:  tfdiags.Error,
+	}
+
+	content, moreDiags := block.Body.Content(checkRuleBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	if attr, exists := content.Attributes["condition"]; exists {
+		cr.Condition = attr.Expr
+	}
+
+	if attr, exists := content.Attributes["error_message"]; exists {
+		cr.ErrorMessage = attr.Expr
+	}
+
+	if attr, exists := content.Attributes["severity"]; exists {
+		sev, sevDiags := decodeCheckRuleSeverity(attr)
+		diags = append(diags, sevDiags...)
+		cr.Severity = sev
+	}
+
+	return cr, diags
+}
+
+// decodeCheckRuleSeverity decodes the "severity" argument of a
+// precondition or postcondition block. The value must be a literal string,
+// either "error" or "warning", because it affects how we process the
+// result of Condition and so can't be deferred until evaluation time the
+// way Condition and ErrorMessage are.
+func decodeCheckRuleSeverity(attr *hcl.Attribute) (tfdiags.Severity, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+
+	val, moreDiags := attr.Expr.Value(nil)
+	diags = append(diags, moreDiags...)
+	if diags.HasErrors() {
+		return tfdiags.Error, diags
+	}
+
+	if val.IsNull() || !val.Type().Equals(cty.String) {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid severity argument",
+			Detail:   `The "severity" argument requires a string value of either "error" or "warning".`,
+			Subject:  attr.Expr.Range().Ptr(),
+		})
+		return tfdiags.Error, diags
+	}
+
+	switch val.AsString() {
+	case "error":
+		return tfdiags.Error, diags
+	case "warning":
+		return tfdiags.Warning, diags
+	default:
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid severity argument",
+			Detail:   fmt.Sprintf(`The "severity" argument requires a string value of either "error" or "warning", not %q.`, val.AsString()),
+			Subject:  attr.Expr.Range().Ptr(),
+		})
+		return tfdiags.Error, diags
+	}
+}
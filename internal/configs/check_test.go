@@ -0,0 +1,79 @@
+package configs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hcltest"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestDecodeCheckRuleSeverity(t *testing.T) {
+	tests := map[string]struct {
+		expr     hcl.Expression
+		want     tfdiags.Severity
+		wantErrs int
+	}{
+		"error": {
+			expr: hcltest.MockExprLiteral(cty.StringVal("error")),
+			want: tfdiags.Error,
+		},
+		"warning": {
+			expr: hcltest.MockExprLiteral(cty.StringVal("warning")),
+			want: tfdiags.Warning,
+		},
+		"invalid string": {
+			expr:     hcltest.MockExprLiteral(cty.StringVal("ignore")),
+			want:     tfdiags.Error,
+			wantErrs: 1,
+		},
+		"null": {
+			expr:     hcltest.MockExprLiteral(cty.NullVal(cty.String)),
+			want:     tfdiags.Error,
+			wantErrs: 1,
+		},
+		"wrong type": {
+			expr:     hcltest.MockExprLiteral(cty.True),
+			want:     tfdiags.Error,
+			wantErrs: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			attr := &hcl.Attribute{
+				Name: "severity",
+				Expr: test.expr,
+			}
+
+			got, diags := decodeCheckRuleSeverity(attr)
+			if got != test.want {
+				t.Errorf("wrong severity\ngot:  %#v\nwant: %#v", got, test.want)
+			}
+			if gotErrs := len(diags.Errs()); gotErrs != test.wantErrs {
+				t.Errorf("wrong number of errors\ngot:  %d\nwant: %d\ndiags: %s", gotErrs, test.wantErrs, diags.Error())
+			}
+		})
+	}
+}
+
+func TestDecodeCheckRuleBlock_severityDefault(t *testing.T) {
+	block := &hcl.Block{
+		Type:     "precondition",
+		Body:     hcl.EmptyBody(),
+		DefRange: hcl.Range{},
+	}
+
+	// An empty body is missing the required "condition" and "error_message"
+	// attributes, so we expect diagnostics, but the default Severity should
+	// still come back as tfdiags.Error rather than the zero value.
+	cr, diags := decodeCheckRuleBlock(block, false)
+	if !diags.HasErrors() {
+		t.Fatalf("expected diagnostics for missing required attributes")
+	}
+	if cr.Severity != tfdiags.Error {
+		t.Errorf("wrong default severity\ngot:  %#v\nwant: %#v", cr.Severity, tfdiags.Error)
+	}
+}
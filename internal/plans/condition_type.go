@@ -1,5 +1,9 @@
 package plans
 
+import (
+	"github.com/hashicorp/terraform/internal/states"
+)
+
 //go:generate go run golang.org/x/tools/cmd/stringer -type ConditionType
 type ConditionType int
 
@@ -9,3 +13,19 @@ const (
 	ResourcePostcondition ConditionType = 2
 	OutputPrecondition    ConditionType = 3
 )
+
+// forStateCheckRuleType converts a ConditionType to the equivalent
+// states.CheckRuleType, for use when promoting a Conditions into a
+// states.CheckResults to be persisted in the state.
+func (c ConditionType) forStateCheckRuleType() states.CheckRuleType {
+	switch c {
+	case ResourcePrecondition:
+		return states.CheckRuleTypeResourcePrecondition
+	case ResourcePostcondition:
+		return states.CheckRuleTypeResourcePostcondition
+	case OutputPrecondition:
+		return states.CheckRuleTypeOutputPrecondition
+	default:
+		return states.CheckRuleTypeInvalid
+	}
+}
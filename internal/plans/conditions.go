@@ -4,6 +4,8 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/states"
+	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
 type Conditions map[string]*ConditionResult
@@ -14,6 +16,11 @@ type ConditionResult struct {
 	Unknown      bool
 	Type         ConditionType
 	ErrorMessage string
+
+	// Severity is the severity the rule was configured with. A failing
+	// result whose Severity is tfdiags.Warning must not block the plan or
+	// apply it was evaluated during, unlike tfdiags.Error.
+	Severity tfdiags.Severity
 }
 
 func NewConditions() Conditions {
@@ -40,3 +47,48 @@ func (cs *ConditionsSync) SetResult(addr string, result *ConditionResult) {
 
 	cs.results[addr] = result
 }
+
+// State promotes the results gathered during a plan or apply walk into a
+// states.CheckResults, ready to be persisted as part of the state so that
+// the last-known status of each check survives into future runs.
+//
+// The returned states.CheckResults is keyed by the same rule address
+// string as the receiver (c), since a resource or output with more than
+// one precondition or postcondition shares a single result.Address across
+// all of them; re-keying by Address instead would silently collapse those
+// distinct rule results down to just one.
+//
+// Results whose Address wasn't recorded are omitted, since there would be
+// nothing meaningful to report them under in the returned
+// states.CheckResults.
+func (c Conditions) State() states.CheckResults {
+	ret := states.NewCheckResults()
+	for ruleAddr, result := range c {
+		if result == nil || result.Address == nil {
+			// Should not happen in practice, but be defensive since a
+			// panic here would abort an otherwise-successful apply.
+			continue
+		}
+		ret[ruleAddr] = &states.CheckResult{
+			Address:      result.Address,
+			Type:         result.Type.forStateCheckRuleType(),
+			Result:       result.Result,
+			Unknown:      result.Unknown,
+			ErrorMessage: result.ErrorMessage,
+			Severity:     result.Severity,
+		}
+	}
+	return ret
+}
+
+// State is a convenience wrapper around Conditions.State that first takes
+// a snapshot of the synchronized results.
+func (cs *ConditionsSync) State() states.CheckResults {
+	if cs == nil {
+		return nil
+	}
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+
+	return cs.results.State()
+}
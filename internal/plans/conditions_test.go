@@ -0,0 +1,89 @@
+package plans
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestConditionsState(t *testing.T) {
+	addr := addrs.AbsResourceInstance{}
+
+	c := NewConditions()
+	c["test_instance.foo.preconditions[0]"] = &ConditionResult{
+		Address:  addr,
+		Result:   true,
+		Type:     ResourcePrecondition,
+		Severity: tfdiags.Error,
+	}
+	c["test_instance.foo.preconditions[1]"] = &ConditionResult{
+		Address:      addr,
+		Result:       false,
+		ErrorMessage: "second precondition failed",
+		Type:         ResourcePrecondition,
+		Severity:     tfdiags.Warning,
+	}
+
+	// A result with no Address recorded should be omitted, since there'd
+	// be nothing meaningful to report it under.
+	c["test_instance.foo.preconditions[2]"] = &ConditionResult{
+		Result: true,
+		Type:   ResourcePrecondition,
+	}
+
+	got := c.State()
+
+	// Both preconditions are attached to the same object, so a naive
+	// re-keying by Address would collapse them down to one entry; keying
+	// by rule address instead must keep them both.
+	if len(got) != 2 {
+		t.Fatalf("wrong number of results: got %d, want 2\n%#v", len(got), got)
+	}
+
+	first, ok := got["test_instance.foo.preconditions[0]"]
+	if !ok {
+		t.Fatalf("missing result for preconditions[0]")
+	}
+	if !first.Result {
+		t.Errorf("wrong result for preconditions[0]: got %v, want true", first.Result)
+	}
+
+	second, ok := got["test_instance.foo.preconditions[1]"]
+	if !ok {
+		t.Fatalf("missing result for preconditions[1]")
+	}
+	if second.Result {
+		t.Errorf("wrong result for preconditions[1]: got %v, want false", second.Result)
+	}
+	if second.ErrorMessage != "second precondition failed" {
+		t.Errorf("wrong error message: got %q", second.ErrorMessage)
+	}
+	if second.Severity != tfdiags.Warning {
+		t.Errorf("wrong severity: got %#v, want %#v", second.Severity, tfdiags.Warning)
+	}
+
+	if _, ok := got["test_instance.foo.preconditions[2]"]; ok {
+		t.Errorf("result with no Address should have been omitted")
+	}
+}
+
+func TestConditionsSyncState(t *testing.T) {
+	var cs *ConditionsSync
+	if got := cs.State(); got != nil {
+		t.Errorf("State on a nil ConditionsSync should return nil, got %#v", got)
+	}
+
+	c := NewConditions()
+	cs = c.SyncWrapper()
+	cs.SetResult("test_instance.foo.preconditions[0]", &ConditionResult{
+		Address: addrs.AbsResourceInstance{},
+		Result:  true,
+		Type:    ResourcePrecondition,
+	})
+
+	got := cs.State()
+	if _, ok := got["test_instance.foo.preconditions[0]"]; !ok {
+		t.Fatalf("expected promoted result, got %#v", got)
+	}
+}
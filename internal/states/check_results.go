@@ -0,0 +1,81 @@
+package states
+
+import (
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// CheckResults records the last-known outcome of each precondition and
+// postcondition check rule in the configuration, keyed by the same rule
+// address string that plans.Conditions uses (for example
+// "aws_instance.foo.preconditions[0]").
+//
+// A resource instance or output value with more than one precondition or
+// postcondition block produces one entry per rule, all sharing the same
+// CheckResult.Address; keying by rule address rather than by Address keeps
+// those entries distinct instead of overwriting one another.
+//
+// CheckResults is the state-side counterpart of the planning-time
+// plans.Conditions, which only exists for the lifetime of a single plan.
+// The state file format does not include CheckResults, so these results
+// are not written out when a state snapshot is persisted.
+type CheckResults map[string]*CheckResult
+
+// CheckResult is the state-persisted counterpart of plans.ConditionResult.
+//
+// It intentionally doesn't reuse plans.ConditionType for its Type field:
+// the plans package already depends on the states package, so states
+// cannot import plans without creating an import cycle. CheckRuleType
+// mirrors plans.ConditionType instead.
+type CheckResult struct {
+	// Address is the resource instance or output value the check rule is
+	// attached to. It is not unique within a CheckResults: every rule
+	// attached to the same object shares the same Address.
+	Address      addrs.Checkable
+	Type         CheckRuleType
+	Result       bool
+	Unknown      bool
+	ErrorMessage string
+
+	// Severity is the severity the check rule was configured with at the
+	// time this result was recorded. A failing result with
+	// tfdiags.Warning severity did not block the run that produced it.
+	Severity tfdiags.Severity
+}
+
+// CheckRuleType identifies which kind of checkable rule produced a
+// CheckResult, mirroring plans.ConditionType.
+type CheckRuleType int
+
+const (
+	CheckRuleTypeInvalid               CheckRuleType = 0
+	CheckRuleTypeResourcePrecondition  CheckRuleType = 1
+	CheckRuleTypeResourcePostcondition CheckRuleType = 2
+	CheckRuleTypeOutputPrecondition    CheckRuleType = 3
+)
+
+// NewCheckResults returns an empty CheckResults, ready to be populated by
+// a caller such as plans.Conditions.State.
+func NewCheckResults() CheckResults {
+	return make(CheckResults)
+}
+
+// DeepCopy returns a new CheckResults containing copies of all of the
+// CheckResult values in the receiver, so that the state snapshot it's
+// embedded in can be safely mutated without affecting other snapshots that
+// share the same underlying results.
+func (rs CheckResults) DeepCopy() CheckResults {
+	if rs == nil {
+		return nil
+	}
+	ret := make(CheckResults, len(rs))
+	for ruleAddr, r := range rs {
+		if r == nil {
+			ret[ruleAddr] = nil
+			continue
+		}
+		rCopy := *r
+		ret[ruleAddr] = &rCopy
+	}
+	return ret
+}
@@ -1,6 +1,12 @@
 package cloud
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
 	"github.com/hashicorp/terraform/internal/states"
 	"github.com/hashicorp/terraform/internal/states/remote"
 	"github.com/hashicorp/terraform/internal/states/statemgr"
@@ -12,6 +18,14 @@ import (
 type State struct {
 	Client remote.Client
 
+	// EnableDelegatedOutputs, when true, causes GetOutputValues to read
+	// outputs out of the delegated remote state rather than the
+	// workspace outputs API. This is only appropriate for callers that
+	// have already established that they hold the broader "read state"
+	// permission and need to opt out of the narrower, outputs-only
+	// codepath explicitly; it must never be enabled by default.
+	EnableDelegatedOutputs bool
+
 	delegate remote.State
 }
 
@@ -40,7 +54,126 @@ func (s *State) PersistState() error {
 	return s.delegate.PersistState()
 }
 
-// GetOutputValues
+// outputsClient is implemented by remote.Client values that can read a
+// workspace's output values directly, without requiring the caller to be
+// authorized to read the workspace's raw state. This is a narrower
+// permission ("read state outputs") than the one enforced by Get, so
+// GetOutputValues prefers it whenever the configured Client supports it.
+type outputsClient interface {
+	Outputs(ctx context.Context) ([]remote.Output, error)
+}
+
+// notAuthorizedError is implemented by the errors an outputsClient returns
+// when the caller lacks the "read state outputs" permission, so that
+// GetOutputValues can surface a clear, actionable message instead of the
+// client's generic error text.
+type notAuthorizedError interface {
+	NotAuthorized() bool
+}
+
+// GetOutputValues fetches the root module output values for the workspace,
+// preferring the authorized workspace outputs API over a full state read so
+// that callers who only hold the "read state outputs" permission (and not
+// "read state") can still retrieve them.
+//
+// If EnableDelegatedOutputs is set, or the configured Client does not
+// implement the outputs API, this instead falls back to reading the
+// outputs out of the delegated remote state.
 func (s *State) GetOutputValues() (map[string]*states.OutputValue, error) {
+	client, ok := s.Client.(outputsClient)
+	if !ok || s.EnableDelegatedOutputs {
+		return s.getOutputValuesFromDelegatedState()
+	}
+
+	outputs, err := client.Outputs(context.Background())
+	if err != nil {
+		return nil, classifyOutputsError(err)
+	}
+
+	return outputValuesFromRemote(outputs)
+}
+
+// classifyOutputsError turns an error returned from an outputsClient's
+// Outputs method into the error GetOutputValues returns, surfacing a clear,
+// actionable message when the failure was a permission error rather than
+// passing the client's generic error text straight through.
+func classifyOutputsError(err error) error {
+	var authErr notAuthorizedError
+	if errors.As(err, &authErr) && authErr.NotAuthorized() {
+		return fmt.Errorf("not authorized to read workspace outputs: %w; "+
+			"if this API token is instead authorized for the broader \"read state\" "+
+			"permission, set EnableDelegatedOutputs to read outputs from the full "+
+			"state", err)
+	}
+	return fmt.Errorf("error fetching workspace outputs: %w", err)
+}
+
+// outputValuesFromRemote converts the outputs returned by an outputsClient
+// into the map[string]*states.OutputValue that GetOutputValues returns,
+// unmarshalling each output's cty type and value out of their JSON
+// representations.
+func outputValuesFromRemote(outputs []remote.Output) (map[string]*states.OutputValue, error) {
+	result := make(map[string]*states.OutputValue, len(outputs))
+	for _, output := range outputs {
+		ty, err := ctyjson.UnmarshalType(output.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type for output %q: %w", output.Name, err)
+		}
+
+		value, err := ctyjson.Unmarshal(output.Value, ty)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for output %q: %w", output.Name, err)
+		}
+
+		result[output.Name] = &states.OutputValue{
+			Value:     value,
+			Sensitive: output.Sensitive,
+		}
+	}
+
+	return result, nil
+}
+
+// getOutputValuesFromDelegatedState reads the full state via the delegate
+// and extracts the root module outputs from it. This requires the caller
+// to be authorized to read the raw state, which is a broader permission
+// than reading outputs alone.
+func (s *State) getOutputValuesFromDelegatedState() (map[string]*states.OutputValue, error) {
+	state := s.delegate.State()
+	if state == nil {
+		return nil, nil
+	}
+
+	root := state.RootModule()
+	if root == nil {
+		return nil, nil
+	}
+
+	return root.OutputValues, nil
+}
+
+// GetCheckResults returns the last-known check results recorded in the
+// delegated remote state, unlike GetOutputValues there is no separate
+// authorized endpoint for these yet, so they always require "read state"
+// access.
+func (s *State) GetCheckResults() states.CheckResults {
+	state := s.delegate.State()
+	if state == nil {
+		return nil
+	}
+
+	return state.CheckResults
+}
+
+// SetCheckResults records the given check results against the delegated
+// in-memory state. A states.CheckResults can be built from a
+// plans.Conditions via Conditions.State; nothing in the apply walk calls
+// this method.
+func (s *State) SetCheckResults(results states.CheckResults) {
+	state := s.delegate.State()
+	if state == nil {
+		return
+	}
 
+	state.CheckResults = results
 }
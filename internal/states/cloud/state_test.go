@@ -0,0 +1,125 @@
+package cloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/states/remote"
+)
+
+type fakeAuthError struct {
+	notAuthorized bool
+}
+
+func (e fakeAuthError) Error() string {
+	return "permission denied"
+}
+
+func (e fakeAuthError) NotAuthorized() bool {
+	return e.notAuthorized
+}
+
+type errString string
+
+func (e errString) Error() string {
+	return string(e)
+}
+
+func TestClassifyOutputsError(t *testing.T) {
+	t.Run("not authorized", func(t *testing.T) {
+		err := classifyOutputsError(fakeAuthError{notAuthorized: true})
+		if !strings.Contains(err.Error(), "not authorized to read workspace outputs") {
+			t.Errorf("expected a permission-specific message, got: %s", err)
+		}
+		if !strings.Contains(err.Error(), "EnableDelegatedOutputs") {
+			t.Errorf("expected the error to point at EnableDelegatedOutputs, got: %s", err)
+		}
+	})
+
+	t.Run("authorized-but-failing client does not get the permission message", func(t *testing.T) {
+		err := classifyOutputsError(fakeAuthError{notAuthorized: false})
+		if strings.Contains(err.Error(), "not authorized") {
+			t.Errorf("did not expect a permission-specific message, got: %s", err)
+		}
+	})
+
+	t.Run("wrapped not-authorized error is still detected", func(t *testing.T) {
+		err := classifyOutputsError(fmt.Errorf("request failed: %w", fakeAuthError{notAuthorized: true}))
+		if !strings.Contains(err.Error(), "not authorized to read workspace outputs") {
+			t.Errorf("expected a permission-specific message, got: %s", err)
+		}
+	})
+
+	t.Run("generic error", func(t *testing.T) {
+		err := classifyOutputsError(errString("boom"))
+		if !strings.Contains(err.Error(), "error fetching workspace outputs") {
+			t.Errorf("expected the generic wrapper message, got: %s", err)
+		}
+		if !strings.Contains(err.Error(), "boom") {
+			t.Errorf("expected the underlying error to be wrapped, got: %s", err)
+		}
+	})
+}
+
+func TestOutputValuesFromRemote(t *testing.T) {
+	t.Run("converts type, value and sensitivity", func(t *testing.T) {
+		got, err := outputValuesFromRemote([]remote.Output{
+			{
+				Name:      "greeting",
+				Type:      []byte(`"string"`),
+				Value:     []byte(`"hello"`),
+				Sensitive: true,
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		out, ok := got["greeting"]
+		if !ok {
+			t.Fatalf("missing output %q in %#v", "greeting", got)
+		}
+		if !out.Sensitive {
+			t.Errorf("expected Sensitive to be true")
+		}
+		if out.Value != cty.StringVal("hello") {
+			t.Errorf("wrong value: got %#v, want %#v", out.Value, cty.StringVal("hello"))
+		}
+	})
+
+	t.Run("malformed type", func(t *testing.T) {
+		_, err := outputValuesFromRemote([]remote.Output{
+			{Name: "broken", Type: []byte(`not json`), Value: []byte(`"hello"`)},
+		})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if !strings.Contains(err.Error(), `invalid type for output "broken"`) {
+			t.Errorf("wrong error message: %s", err)
+		}
+	})
+
+	t.Run("malformed value", func(t *testing.T) {
+		_, err := outputValuesFromRemote([]remote.Output{
+			{Name: "broken", Type: []byte(`"string"`), Value: []byte(`not json`)},
+		})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if !strings.Contains(err.Error(), `invalid value for output "broken"`) {
+			t.Errorf("wrong error message: %s", err)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		got, err := outputValuesFromRemote(nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("expected no outputs, got %#v", got)
+		}
+	})
+}
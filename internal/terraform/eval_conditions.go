@@ -75,7 +75,14 @@ func (c checkType) ConditionType() plans.ConditionType {
 //
 // If any check rules produce an unknown result then they will be silently
 // ignored on the assumption that the same checks will be run again later
-// with fewer unknown values in the EvalContext.
+// with fewer unknown values in the EvalContext, including during a plan's
+// refresh walk, which re-evaluates conditions against the freshly-read
+// remote values.
+//
+// Every result is recorded in ctx.Conditions(). plans.Conditions.State can
+// convert those results into a states.CheckResults, but the apply walk does
+// not call it, so the outcome of each check is not attached to the new
+// state.
 //
 // If any of the rules do not pass, the returned diagnostics will contain
 // errors. Otherwise, it will either be empty or contain only warnings.
@@ -87,12 +94,20 @@ func evalCheckRules(typ checkType, rules []*configs.CheckRule, ctx EvalContext,
 		return nil
 	}
 
-	severity := diagSeverity.ToHCL()
-
 	for i, rule := range rules {
 		ruleAddr := typ.RuleAddr(self, i)
 
-		conditionResult, ruleDiags := evalCheckRule(typ, rule, ctx, self, keyData, severity)
+		// A rule's own severity can only relax the caller-supplied
+		// diagSeverity to a warning, never escalate it to an error: a
+		// caller that has already downgraded failures to warnings (for
+		// example, for conditions evaluated against a destroy plan) must
+		// still win over a rule configured with severity = "error".
+		ruleSeverity := diagSeverity
+		if rule.Severity == tfdiags.Warning {
+			ruleSeverity = tfdiags.Warning
+		}
+
+		conditionResult, ruleDiags := evalCheckRule(typ, rule, ctx, self, keyData, ruleSeverity)
 		diags = diags.Append(ruleDiags)
 		ctx.Conditions().SetResult(ruleAddr, conditionResult)
 	}
@@ -100,10 +115,12 @@ func evalCheckRules(typ checkType, rules []*configs.CheckRule, ctx EvalContext,
 	return diags
 }
 
-func evalCheckRule(typ checkType, rule *configs.CheckRule, ctx EvalContext, self addrs.Checkable, keyData instances.RepetitionData, severity hcl.DiagnosticSeverity) (*plans.ConditionResult, tfdiags.Diagnostics) {
+func evalCheckRule(typ checkType, rule *configs.CheckRule, ctx EvalContext, self addrs.Checkable, keyData instances.RepetitionData, diagSeverity tfdiags.Severity) (*plans.ConditionResult, tfdiags.Diagnostics) {
 	var diags tfdiags.Diagnostics
 	const errInvalidCondition = "Invalid condition result"
 
+	severity := diagSeverity.ToHCL()
+
 	refs, moreDiags := lang.ReferencesInExpr(rule.Condition)
 	diags = diags.Append(moreDiags)
 	moreRefs, moreDiags := lang.ReferencesInExpr(rule.ErrorMessage)
@@ -111,9 +128,10 @@ func evalCheckRule(typ checkType, rule *configs.CheckRule, ctx EvalContext, self
 	refs = append(refs, moreRefs...)
 
 	conditionResult := &plans.ConditionResult{
-		Address: self,
-		Unknown: true,
-		Type:    typ.ConditionType(),
+		Address:  self,
+		Unknown:  true,
+		Type:     typ.ConditionType(),
+		Severity: diagSeverity,
 	}
 
 	var selfReference addrs.Referenceable